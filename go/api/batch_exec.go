@@ -0,0 +1,105 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// rawCommandClient is the low-level escape hatch every client exposes for sending a command
+// the typed API doesn't wrap directly. executeBatch is built on it so Pipeline/Transaction
+// don't need a separate command-generation path of their own.
+type rawCommandClient interface {
+	CustomCommand(ctx context.Context, args []string) (any, error)
+}
+
+// batchResponse is one command's outcome within a batch: either a value or the error that
+// command itself returned. It is distinct from the error executeBatch itself returns, which
+// reports a failure of the batch as a whole (for example, a transport error that means no
+// response was read for anything after it).
+type batchResponse struct {
+	value any
+	err   error
+}
+
+// executeBatch sends commands to client in order, wrapping them in WATCH/MULTI/EXEC when
+// transactional, and returns one batchResponse per command in the same order. A nil responses
+// slice with a nil error means EXEC aborted because a watched key changed. In the
+// non-transactional case, one command failing does not stop the rest from being sent - each
+// command's own error is carried on its batchResponse so every command still gets a response.
+func executeBatch(
+	ctx context.Context,
+	client rawCommandClient,
+	commands []pipelineCommand,
+	transactional bool,
+	watch []string,
+) ([]batchResponse, error) {
+	if !transactional {
+		responses := make([]batchResponse, len(commands))
+		for i, cmd := range commands {
+			response, err := client.CustomCommand(ctx, append([]string{cmd.requestType}, cmd.args...))
+			responses[i] = batchResponse{value: response, err: err}
+		}
+		return responses, nil
+	}
+
+	for _, key := range watch {
+		if _, err := client.CustomCommand(ctx, []string{"WATCH", key}); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := client.CustomCommand(ctx, []string{"MULTI"}); err != nil {
+		return nil, err
+	}
+	for _, cmd := range commands {
+		if _, err := client.CustomCommand(ctx, append([]string{cmd.requestType}, cmd.args...)); err != nil {
+			// A command is rejected at queue time (wrong arity, unknown command, ...) rather than
+			// at EXEC time, so the transaction as a whole never runs; there is nothing per-command
+			// to resolve.
+			_, _ = client.CustomCommand(ctx, []string{"DISCARD"})
+			return nil, err
+		}
+	}
+
+	response, err := client.CustomCommand(ctx, []string{"EXEC"})
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		// The server returns a null EXEC reply when a watched key changed.
+		return nil, nil
+	}
+	rawResponses, ok := response.([]any)
+	if !ok {
+		return nil, fmt.Errorf("api: unexpected EXEC response type %T", response)
+	}
+	responses := make([]batchResponse, len(rawResponses))
+	for i, value := range rawResponses {
+		responses[i] = batchResponse{value: value}
+	}
+	return responses, nil
+}
+
+// executeBatch implements batchExecutor for GlideClient by sending every queued command
+// through CustomCommand, wrapped in WATCH/MULTI/EXEC when the Pipeline is a Transaction.
+func (client *GlideClient) executeBatch(
+	ctx context.Context,
+	commands []pipelineCommand,
+	transactional bool,
+	watch []string,
+) ([]batchResponse, error) {
+	return executeBatch(ctx, client, commands, transactional, watch)
+}
+
+// executeBatch implements batchExecutor for GlideClusterClient the same way GlideClient does;
+// ClusterPipeline.Exec is what splits the commands by hash slot before calling this once per
+// slot.
+func (client *GlideClusterClient) executeBatch(
+	ctx context.Context,
+	commands []pipelineCommand,
+	transactional bool,
+	watch []string,
+) ([]batchResponse, error) {
+	return executeBatch(ctx, client, commands, transactional, watch)
+}
@@ -0,0 +1,260 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// CacheStats reports cumulative client-side cache activity for a CachingClient or
+// CachingClusterClient.
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
+type cacheBypassContextKey struct{}
+
+// WithoutCache returns a copy of ctx that instructs a CachingClient or CachingClusterClient
+// to skip the client-side cache for the single call it is passed to, reading through to the
+// server and leaving any existing cache entry untouched.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassContextKey{}, true)
+}
+
+func isCacheBypassed(ctx context.Context) bool {
+	bypassed, _ := ctx.Value(cacheBypassContextKey{}).(bool)
+	return bypassed
+}
+
+type cacheEntry struct {
+	cacheKey  string
+	value     any
+	expiresAt time.Time
+	dataKeys  []string
+}
+
+// commandCache is an LRU-backed store of cached command responses. Entries are keyed by the
+// fully-qualified request (command name plus arguments) and indexed by the data keys they
+// were derived from, so a single invalidation can drop every cached response that read it.
+type commandCache struct {
+	mu        sync.Mutex
+	opts      options.CacheOptions
+	ll        *list.List
+	entries   map[string]*list.Element
+	byDataKey map[string]map[string]struct{}
+	stats     CacheStats
+}
+
+func newCommandCache(opts options.CacheOptions) *commandCache {
+	return &commandCache{
+		opts:      opts,
+		ll:        list.New(),
+		entries:   make(map[string]*list.Element),
+		byDataKey: make(map[string]map[string]struct{}),
+	}
+}
+
+// eligible reports whether command/key is allowed to be cached under opts.
+func (c *commandCache) eligible(command string, keys ...string) bool {
+	if commands := c.opts.Commands(); len(commands) > 0 {
+		allowed := false
+		for _, cmd := range commands {
+			if cmd == command {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	for _, key := range keys {
+		for _, denied := range c.opts.DenyList() {
+			if denied == key {
+				return false
+			}
+		}
+	}
+	if allowList := c.opts.AllowList(); len(allowList) > 0 {
+		for _, key := range keys {
+			found := false
+			for _, allowed := range allowList {
+				if allowed == key {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func cacheKey(command string, keys ...string) string {
+	key := command
+	for _, k := range keys {
+		key += "\x00" + k
+	}
+	return key
+}
+
+func (c *commandCache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.removeElement(elem)
+		c.stats.Misses++
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	c.stats.Hits++
+	return entry.value, true
+}
+
+func (c *commandCache) set(key string, dataKeys []string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl := c.opts.TTL(); ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		c.ll.MoveToFront(elem)
+		elem.Value.(*cacheEntry).value = value
+		elem.Value.(*cacheEntry).expiresAt = expiresAt
+		return
+	}
+
+	entry := &cacheEntry{cacheKey: key, value: value, expiresAt: expiresAt, dataKeys: dataKeys}
+	elem := c.ll.PushFront(entry)
+	c.entries[key] = elem
+	for _, dataKey := range dataKeys {
+		if c.byDataKey[dataKey] == nil {
+			c.byDataKey[dataKey] = make(map[string]struct{})
+		}
+		c.byDataKey[dataKey][key] = struct{}{}
+	}
+
+	if max := c.opts.MaxEntries(); max > 0 && c.ll.Len() > max {
+		c.evictOldest()
+	}
+}
+
+func (c *commandCache) evictOldest() {
+	if elem := c.ll.Back(); elem != nil {
+		c.removeElement(elem)
+		c.stats.Evictions++
+	}
+}
+
+// removeElement drops elem from the LRU and its data-key index. Callers must hold c.mu.
+func (c *commandCache) removeElement(elem *list.Element) {
+	entry := elem.Value.(*cacheEntry)
+	c.ll.Remove(elem)
+	delete(c.entries, entry.cacheKey)
+	for _, dataKey := range entry.dataKeys {
+		delete(c.byDataKey[dataKey], entry.cacheKey)
+		if len(c.byDataKey[dataKey]) == 0 {
+			delete(c.byDataKey, dataKey)
+		}
+	}
+}
+
+// invalidate evicts every cached response derived from any of the given data keys, whether
+// because a server-side invalidation push named them or a write went through this client.
+func (c *commandCache) invalidate(dataKeys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, dataKey := range dataKeys {
+		for cacheKey := range c.byDataKey[dataKey] {
+			if elem, ok := c.entries[cacheKey]; ok {
+				c.removeElement(elem)
+			}
+		}
+	}
+}
+
+func (c *commandCache) snapshotStats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// trackingConn is the minimal surface the caching layer needs from a dedicated RESP3
+// connection used solely to receive server-side invalidation pushes.
+type trackingConn interface {
+	// EnableTracking issues CLIENT TRACKING ON REDIRECT <redirectClientID> on the connection.
+	EnableTracking(ctx context.Context, redirectClientID int64) error
+	// ReceiveInvalidation blocks for the next invalidation push and returns the keys it named,
+	// or nil keys on a flush-all ("tracking reset") notification.
+	ReceiveInvalidation(ctx context.Context) (keys []string, flushAll bool, err error)
+	Close() error
+}
+
+// trackingListener subscribes to a connection's invalidation pushes and evicts the matching
+// entries from a commandCache until stopped or the connection errors out.
+type trackingListener struct {
+	conn   trackingConn
+	cache  *commandCache
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func startTrackingListener(conn trackingConn, cache *commandCache, redirectClientID int64) (*trackingListener, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := conn.EnableTracking(ctx, redirectClientID); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	listener := &trackingListener{conn: conn, cache: cache, cancel: cancel, done: make(chan struct{})}
+	go listener.run(ctx)
+	return listener, nil
+}
+
+func (l *trackingListener) run(ctx context.Context) {
+	defer close(l.done)
+	for {
+		keys, flushAll, err := l.conn.ReceiveInvalidation(ctx)
+		if err != nil {
+			return
+		}
+		if flushAll {
+			l.cache.mu.Lock()
+			l.cache.ll.Init()
+			l.cache.entries = make(map[string]*list.Element)
+			l.cache.byDataKey = make(map[string]map[string]struct{})
+			l.cache.mu.Unlock()
+			continue
+		}
+		l.cache.invalidate(keys...)
+	}
+}
+
+func (l *trackingListener) stop() {
+	l.cancel()
+	// run is blocked in ReceiveInvalidation, which may not respect ctx (some connection
+	// implementations only unblock once the underlying socket itself is closed), so close the
+	// conn before waiting on done or stop could hang forever.
+	l.conn.Close()
+	<-l.done
+}
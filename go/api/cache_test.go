@@ -0,0 +1,87 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// fakeTrackingConn is a minimal trackingConn fixture for examples and tests: it never reports
+// a real invalidation, it just blocks until Close is called.
+type fakeTrackingConn struct {
+	closed chan struct{}
+}
+
+func newFakeTrackingConn() *fakeTrackingConn {
+	return &fakeTrackingConn{closed: make(chan struct{})}
+}
+
+func (f *fakeTrackingConn) EnableTracking(ctx context.Context, redirectClientID int64) error {
+	return nil
+}
+
+func (f *fakeTrackingConn) ReceiveInvalidation(ctx context.Context) ([]string, bool, error) {
+	<-f.closed
+	return nil, false, errors.New("fakeTrackingConn: connection closed")
+}
+
+func (f *fakeTrackingConn) Close() error {
+	select {
+	case <-f.closed:
+	default:
+		close(f.closed)
+	}
+	return nil
+}
+
+func getExampleTrackingConn() trackingConn {
+	return newFakeTrackingConn()
+}
+
+func ExampleNewCachingClient() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	cacheOpts := options.NewCacheOptions().SetMaxEntries(1000)
+	cachingClient, err := NewCachingClient(client, *cacheOpts, getExampleTrackingConn(), 0)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	cachingClient.Set(context.Background(), "my_key", "my_value")
+	cachingClient.Get(context.Background(), "my_key") // miss, populates the cache
+
+	// served from the cache
+	result, _ := cachingClient.Get(context.Background(), "my_key")
+	fmt.Println(result.Value())
+	fmt.Println(cachingClient.CacheStats().Hits)
+
+	cachingClient.Close() // stops the tracking connection
+
+	// Output:
+	// my_value
+	// 1
+}
+
+func ExampleWithoutCache() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	cachingClient, err := NewCachingClient(client, *options.NewCacheOptions(), getExampleTrackingConn(), 0)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	cachingClient.Set(context.Background(), "my_key", "my_value")
+	result, _ := cachingClient.Get(WithoutCache(context.Background()), "my_key")
+	fmt.Println(result.Value())
+	fmt.Println(cachingClient.CacheStats().Hits)
+
+	cachingClient.Close() // stops the tracking connection
+
+	// Output:
+	// my_value
+	// 0
+}
@@ -0,0 +1,250 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// CachingClient wraps a GlideClient with an opt-in, transparent client-side cache for read
+// commands. Reads are served from a local LRU when possible; writes issued through the same
+// CachingClient proactively invalidate the keys they touch, and a dedicated RESP3 connection
+// subscribed via CLIENT TRACKING evicts entries invalidated by writes from other clients.
+//
+// Use NewCachingClient to construct one; CachingClient.Close stops the tracking connection and
+// then closes the underlying GlideClient. Closing the underlying GlideClient directly does not
+// stop the tracking connection.
+type CachingClient struct {
+	*GlideClient
+	cache    *commandCache
+	tracking *trackingListener
+}
+
+// NewCachingClient wraps client with a cache configured by opts. conn is a dedicated RESP3
+// connection used exclusively to receive invalidation pushes; redirectClientID is the ID
+// CLIENT TRACKING should redirect invalidation messages to.
+func NewCachingClient(
+	client *GlideClient,
+	opts options.CacheOptions,
+	conn trackingConn,
+	redirectClientID int64,
+) (*CachingClient, error) {
+	cache := newCommandCache(opts)
+	tracking, err := startTrackingListener(conn, cache, redirectClientID)
+	if err != nil {
+		return nil, err
+	}
+	return &CachingClient{GlideClient: client, cache: cache, tracking: tracking}, nil
+}
+
+// CacheStats returns cumulative hit/miss/eviction counts for this client's cache.
+func (c *CachingClient) CacheStats() CacheStats {
+	return c.cache.snapshotStats()
+}
+
+// Close stops the tracking connection before delegating to the underlying GlideClient.
+func (c *CachingClient) Close() error {
+	c.tracking.stop()
+	return c.GlideClient.Close()
+}
+
+func (c *CachingClient) Get(ctx context.Context, key string) (Result[string], error) {
+	const command = "GET"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		if cached, ok := c.cache.get(cacheKey(command, key)); ok {
+			return cached.(Result[string]), nil
+		}
+	}
+
+	result, err := c.GlideClient.Get(ctx, key)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		c.cache.set(cacheKey(command, key), []string{key}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClient) GetEx(ctx context.Context, key string) (Result[string], error) {
+	// GetEx can refresh or clear the key's expiry, so treat it like a write for cache purposes:
+	// always invalidate rather than serve or populate a cached value.
+	result, err := c.GlideClient.GetEx(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) MGet(ctx context.Context, keys []string) ([]Result[string], error) {
+	const command = "MGET"
+	cKey := cacheKey(command, keys...)
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, keys...) {
+		if cached, ok := c.cache.get(cKey); ok {
+			return cached.([]Result[string]), nil
+		}
+	}
+
+	result, err := c.GlideClient.MGet(ctx, keys)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, keys...) {
+		c.cache.set(cKey, keys, result)
+	}
+	return result, err
+}
+
+func (c *CachingClient) Strlen(ctx context.Context, key string) (int64, error) {
+	const command = "STRLEN"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		if cached, ok := c.cache.get(cacheKey(command, key)); ok {
+			return cached.(int64), nil
+		}
+	}
+
+	result, err := c.GlideClient.Strlen(ctx, key)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		c.cache.set(cacheKey(command, key), []string{key}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClient) GetRange(ctx context.Context, key string, start int64, end int64) (string, error) {
+	const command = "GETRANGE"
+	rangeKey := cacheKey(command, key, strconv.FormatInt(start, 10), strconv.FormatInt(end, 10))
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		if cached, ok := c.cache.get(rangeKey); ok {
+			return cached.(string), nil
+		}
+	}
+
+	result, err := c.GlideClient.GetRange(ctx, key, start, end)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		c.cache.set(rangeKey, []string{key}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClient) LCS(ctx context.Context, key1 string, key2 string) (string, error) {
+	const command = "LCS"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		if cached, ok := c.cache.get(cacheKey(command, key1, key2)); ok {
+			return cached.(string), nil
+		}
+	}
+
+	result, err := c.GlideClient.LCS(ctx, key1, key2)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		c.cache.set(cacheKey(command, key1, key2), []string{key1, key2}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClient) LCSLen(ctx context.Context, key1 string, key2 string) (int64, error) {
+	const command = "LCSLEN"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		if cached, ok := c.cache.get(cacheKey(command, key1, key2)); ok {
+			return cached.(int64), nil
+		}
+	}
+
+	result, err := c.GlideClient.LCSLen(ctx, key1, key2)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		c.cache.set(cacheKey(command, key1, key2), []string{key1, key2}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClient) Set(ctx context.Context, key string, value string) (string, error) {
+	result, err := c.GlideClient.Set(ctx, key, value)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) SetRange(ctx context.Context, key string, offset int64, value string) (int64, error) {
+	result, err := c.GlideClient.SetRange(ctx, key, offset, value)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) Append(ctx context.Context, key string, value string) (int64, error) {
+	result, err := c.GlideClient.Append(ctx, key, value)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) Incr(ctx context.Context, key string) (int64, error) {
+	result, err := c.GlideClient.Incr(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) IncrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	result, err := c.GlideClient.IncrBy(ctx, key, amount)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) IncrByFloat(ctx context.Context, key string, amount float64) (float64, error) {
+	result, err := c.GlideClient.IncrByFloat(ctx, key, amount)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) Decr(ctx context.Context, key string) (int64, error) {
+	result, err := c.GlideClient.Decr(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) DecrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	result, err := c.GlideClient.DecrBy(ctx, key, amount)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) GetDel(ctx context.Context, key string) (Result[string], error) {
+	result, err := c.GlideClient.GetDel(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClient) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	result, err := c.GlideClient.MSet(ctx, keyValueMap)
+	if err == nil {
+		c.cache.invalidate(keysOf(keyValueMap)...)
+	}
+	return result, err
+}
+
+func (c *CachingClient) MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error) {
+	result, err := c.GlideClient.MSetNX(ctx, keyValueMap)
+	if err == nil && result {
+		c.cache.invalidate(keysOf(keyValueMap)...)
+	}
+	return result, err
+}
+
+func keysOf(keyValueMap map[string]string) []string {
+	keys := make([]string, 0, len(keyValueMap))
+	for key := range keyValueMap {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,251 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// CachingClusterClient wraps a GlideClusterClient with the same opt-in client-side cache as
+// CachingClient. Because cluster keys are distributed across shards, invalidation pushes are
+// received over one tracking connection per node and routed into a single shared cache keyed
+// by the data keys involved, so a write on any node evicts the right entries regardless of
+// which node served the original read.
+type CachingClusterClient struct {
+	*GlideClusterClient
+	cache    *commandCache
+	tracking map[string]*trackingListener
+}
+
+// NewCachingClusterClient wraps client with a cache configured by opts. nodeConns maps each
+// node's address to a dedicated RESP3 connection used exclusively to receive that node's
+// invalidation pushes; redirectClientID is the ID CLIENT TRACKING should redirect to on every
+// node.
+func NewCachingClusterClient(
+	client *GlideClusterClient,
+	opts options.CacheOptions,
+	nodeConns map[string]trackingConn,
+	redirectClientID int64,
+) (*CachingClusterClient, error) {
+	cache := newCommandCache(opts)
+	tracking := make(map[string]*trackingListener, len(nodeConns))
+	for addr, conn := range nodeConns {
+		listener, err := startTrackingListener(conn, cache, redirectClientID)
+		if err != nil {
+			for _, started := range tracking {
+				started.stop()
+			}
+			return nil, err
+		}
+		tracking[addr] = listener
+	}
+	return &CachingClusterClient{GlideClusterClient: client, cache: cache, tracking: tracking}, nil
+}
+
+// CacheStats returns cumulative hit/miss/eviction counts for this client's cache, aggregated
+// across every node's tracking connection.
+func (c *CachingClusterClient) CacheStats() CacheStats {
+	return c.cache.snapshotStats()
+}
+
+// Close stops every node's tracking connection before delegating to the underlying
+// GlideClusterClient.
+func (c *CachingClusterClient) Close() error {
+	for _, listener := range c.tracking {
+		listener.stop()
+	}
+	return c.GlideClusterClient.Close()
+}
+
+func (c *CachingClusterClient) Get(ctx context.Context, key string) (Result[string], error) {
+	const command = "GET"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		if cached, ok := c.cache.get(cacheKey(command, key)); ok {
+			return cached.(Result[string]), nil
+		}
+	}
+
+	result, err := c.GlideClusterClient.Get(ctx, key)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		c.cache.set(cacheKey(command, key), []string{key}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) GetEx(ctx context.Context, key string) (Result[string], error) {
+	// GetEx can refresh or clear the key's expiry, so treat it like a write for cache purposes:
+	// always invalidate rather than serve or populate a cached value.
+	result, err := c.GlideClusterClient.GetEx(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) MGet(ctx context.Context, keys []string) ([]Result[string], error) {
+	const command = "MGET"
+	cKey := cacheKey(command, keys...)
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, keys...) {
+		if cached, ok := c.cache.get(cKey); ok {
+			return cached.([]Result[string]), nil
+		}
+	}
+
+	result, err := c.GlideClusterClient.MGet(ctx, keys)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, keys...) {
+		c.cache.set(cKey, keys, result)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) Strlen(ctx context.Context, key string) (int64, error) {
+	const command = "STRLEN"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		if cached, ok := c.cache.get(cacheKey(command, key)); ok {
+			return cached.(int64), nil
+		}
+	}
+
+	result, err := c.GlideClusterClient.Strlen(ctx, key)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		c.cache.set(cacheKey(command, key), []string{key}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) GetRange(ctx context.Context, key string, start int64, end int64) (string, error) {
+	const command = "GETRANGE"
+	rangeKey := cacheKey(command, key, strconv.FormatInt(start, 10), strconv.FormatInt(end, 10))
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		if cached, ok := c.cache.get(rangeKey); ok {
+			return cached.(string), nil
+		}
+	}
+
+	result, err := c.GlideClusterClient.GetRange(ctx, key, start, end)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key) {
+		c.cache.set(rangeKey, []string{key}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) LCS(ctx context.Context, key1 string, key2 string) (string, error) {
+	const command = "LCS"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		if cached, ok := c.cache.get(cacheKey(command, key1, key2)); ok {
+			return cached.(string), nil
+		}
+	}
+
+	result, err := c.GlideClusterClient.LCS(ctx, key1, key2)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		c.cache.set(cacheKey(command, key1, key2), []string{key1, key2}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) LCSLen(ctx context.Context, key1 string, key2 string) (int64, error) {
+	const command = "LCSLEN"
+	if !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		if cached, ok := c.cache.get(cacheKey(command, key1, key2)); ok {
+			return cached.(int64), nil
+		}
+	}
+
+	result, err := c.GlideClusterClient.LCSLen(ctx, key1, key2)
+	if err == nil && !isCacheBypassed(ctx) && c.cache.eligible(command, key1, key2) {
+		c.cache.set(cacheKey(command, key1, key2), []string{key1, key2}, result)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) Set(ctx context.Context, key string, value string) (string, error) {
+	result, err := c.GlideClusterClient.Set(ctx, key, value)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) SetRange(ctx context.Context, key string, offset int64, value string) (int64, error) {
+	result, err := c.GlideClusterClient.SetRange(ctx, key, offset, value)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) Append(ctx context.Context, key string, value string) (int64, error) {
+	result, err := c.GlideClusterClient.Append(ctx, key, value)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) Incr(ctx context.Context, key string) (int64, error) {
+	result, err := c.GlideClusterClient.Incr(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) IncrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	result, err := c.GlideClusterClient.IncrBy(ctx, key, amount)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) IncrByFloat(ctx context.Context, key string, amount float64) (float64, error) {
+	result, err := c.GlideClusterClient.IncrByFloat(ctx, key, amount)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) Decr(ctx context.Context, key string) (int64, error) {
+	result, err := c.GlideClusterClient.Decr(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) DecrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	result, err := c.GlideClusterClient.DecrBy(ctx, key, amount)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) GetDel(ctx context.Context, key string) (Result[string], error) {
+	result, err := c.GlideClusterClient.GetDel(ctx, key)
+	if err == nil {
+		c.cache.invalidate(key)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	result, err := c.GlideClusterClient.MSet(ctx, keyValueMap)
+	if err == nil {
+		c.cache.invalidate(keysOf(keyValueMap)...)
+	}
+	return result, err
+}
+
+func (c *CachingClusterClient) MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error) {
+	result, err := c.GlideClusterClient.MSetNX(ctx, keyValueMap)
+	if err == nil && result {
+		c.cache.invalidate(keysOf(keyValueMap)...)
+	}
+	return result, err
+}
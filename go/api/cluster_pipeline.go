@@ -0,0 +1,183 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const clusterSlotCount = 16384
+
+// ErrCrossSlotTransaction is returned by ClusterPipeline.Exec when a Transaction's queued
+// commands don't all share a single hash slot. A cluster transaction can only execute
+// atomically on one shard, so Exec refuses to silently split it into multiple independently
+// committed sub-batches.
+var ErrCrossSlotTransaction = errors.New("api: transaction commands span more than one hash slot")
+
+// PipelineError is returned by ClusterPipeline.Exec when one or more sub-batches failed while
+// the rest succeeded. Commands not listed in Failures resolved normally.
+type PipelineError struct {
+	Failures []PipelineCommandError
+}
+
+// PipelineCommandError names one command that failed within a ClusterPipeline batch.
+type PipelineCommandError struct {
+	Index       int
+	RequestType string
+	Err         error
+}
+
+func (e *PipelineError) Error() string {
+	names := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		names = append(names, fmt.Sprintf("%s (index %d): %v", failure.RequestType, failure.Index, failure.Err))
+	}
+	return fmt.Sprintf("api: %d of the pipeline's commands failed: %s", len(e.Failures), strings.Join(names, "; "))
+}
+
+// ClusterPipeline is a Pipeline whose Exec splits the queued commands by hash slot and fans
+// the resulting sub-batches out to their owning shards concurrently, reassembling the
+// responses in original queue order. Build one with GlideClusterClient.Pipeline or
+// GlideClusterClient.Transaction.
+type ClusterPipeline struct {
+	Pipeline
+}
+
+// Exec flushes the queued commands, grouped by hash slot and dispatched one sub-batch per
+// hash slot concurrently, then resolves every PipelineResult in original queue order. If any
+// sub-batch fails, Exec still resolves the commands that succeeded and returns a *PipelineError
+// describing the rest. For a ClusterPipeline returned by Transaction, Exec instead returns
+// ErrCrossSlotTransaction without sending anything if the queued commands span more than one
+// hash slot, since a cluster transaction can only execute atomically on one shard, and returns
+// ErrTxAborted if a watched key changed before EXEC.
+func (p *ClusterPipeline) Exec(ctx context.Context) error {
+	if p.discarded {
+		return fmt.Errorf("api: pipeline was discarded")
+	}
+
+	slotGroups := make(map[int][]int) // slot -> indices into p.commands, in queue order
+	order := make([]int, 0, len(p.commands))
+	for i, cmd := range p.commands {
+		slot := 0
+		if len(cmd.keys) > 0 {
+			slot = clusterSlot(cmd.keys[0])
+		}
+		if _, seen := slotGroups[slot]; !seen {
+			order = append(order, slot)
+		}
+		slotGroups[slot] = append(slotGroups[slot], i)
+	}
+
+	if p.transactional && len(order) > 1 {
+		for _, cmd := range p.commands {
+			cmd.resolve(nil, ErrCrossSlotTransaction)
+		}
+		return ErrCrossSlotTransaction
+	}
+
+	responses := make([]any, len(p.commands))
+	errs := make([]error, len(p.commands))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, slot := range order {
+		indices := slotGroups[slot]
+		wg.Add(1)
+		go func(indices []int) {
+			defer wg.Done()
+			subCommands := make([]pipelineCommand, len(indices))
+			for i, idx := range indices {
+				subCommands[i] = p.commands[idx]
+			}
+			subResponses, err := p.executor.executeBatch(ctx, subCommands, p.transactional, p.watch)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				for _, idx := range indices {
+					errs[idx] = err
+				}
+				return
+			}
+			if subResponses == nil && p.transactional {
+				for _, idx := range indices {
+					errs[idx] = ErrTxAborted
+				}
+				return
+			}
+			for i, idx := range indices {
+				if i < len(subResponses) {
+					responses[idx] = subResponses[i].value
+					errs[idx] = subResponses[i].err
+				}
+			}
+		}(indices)
+	}
+	wg.Wait()
+
+	if p.transactional && len(p.commands) > 0 && errs[0] == ErrTxAborted {
+		for _, cmd := range p.commands {
+			cmd.resolve(nil, ErrTxAborted)
+		}
+		return ErrTxAborted
+	}
+
+	var failures []PipelineCommandError
+	for i, cmd := range p.commands {
+		if errs[i] != nil {
+			failures = append(failures, PipelineCommandError{Index: i, RequestType: cmd.requestType, Err: errs[i]})
+			cmd.resolve(nil, errs[i])
+			continue
+		}
+		cmd.resolve(responses[i], nil)
+	}
+	if len(failures) > 0 {
+		return &PipelineError{Failures: failures}
+	}
+	return nil
+}
+
+// Pipeline returns a ClusterPipeline that queues commands for a non-transactional batch write
+// against this client, split by hash slot at Exec time.
+func (client *GlideClusterClient) Pipeline() *ClusterPipeline {
+	return &ClusterPipeline{Pipeline: *newPipeline(client)}
+}
+
+// Transaction returns a ClusterPipeline that wraps its queued commands in MULTI/EXEC. All
+// commands in a single transaction must share a hash slot - typically by sharing a hash tag -
+// since a cluster transaction executes atomically on one shard; Exec returns
+// ErrCrossSlotTransaction instead of running the batch if they don't.
+func (client *GlideClusterClient) Transaction(watch ...string) *ClusterPipeline {
+	return &ClusterPipeline{Pipeline: *newTransaction(client, watch)}
+}
+
+// clusterSlot computes the hash slot a key is routed to, honoring {hash tags} the same way the
+// server does: if key contains a "{...}" substring with at least one character inside the
+// braces, only that substring is hashed.
+func clusterSlot(key string) int {
+	hashKey := key
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			hashKey = key[start+1 : start+1+end]
+		}
+	}
+	return int(crc16([]byte(hashKey)) % clusterSlotCount)
+}
+
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = (crc << 1) ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
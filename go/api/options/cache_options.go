@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "time"
+
+// CacheOptions represents the configuration for the opt-in client-side caching layer.
+//
+// A zero-value CacheOptions caches every supported read command with no entry cap and no TTL;
+// use NewCacheOptions to obtain usable defaults and the builder methods below to customize them.
+type CacheOptions struct {
+	maxEntries int
+	ttl        time.Duration
+	allowList  []string
+	denyList   []string
+	commands   []string
+}
+
+// NewCacheOptions returns a CacheOptions with a 10000 entry cap and no TTL, allow-list, or
+// deny-list configured.
+func NewCacheOptions() *CacheOptions {
+	return &CacheOptions{maxEntries: 10000}
+}
+
+// SetMaxEntries sets the maximum number of cached responses kept in the client-side LRU
+// before the oldest entries are evicted.
+func (opts *CacheOptions) SetMaxEntries(maxEntries int) *CacheOptions {
+	opts.maxEntries = maxEntries
+	return opts
+}
+
+// SetTTL sets how long a cached response remains valid in the absence of an invalidation
+// message from the server. A zero value, the default, disables time-based expiry.
+func (opts *CacheOptions) SetTTL(ttl time.Duration) *CacheOptions {
+	opts.ttl = ttl
+	return opts
+}
+
+// SetAllowList restricts caching to the given keys. When empty, the default, all keys are
+// eligible for caching unless excluded by SetDenyList.
+func (opts *CacheOptions) SetAllowList(keys []string) *CacheOptions {
+	opts.allowList = keys
+	return opts
+}
+
+// SetDenyList excludes the given keys from caching, taking precedence over SetAllowList.
+func (opts *CacheOptions) SetDenyList(keys []string) *CacheOptions {
+	opts.denyList = keys
+	return opts
+}
+
+// SetCommands opts a specific set of command names (for example "GET", "MGET") into
+// caching. When empty, the default, every supported read command is cached.
+func (opts *CacheOptions) SetCommands(commands []string) *CacheOptions {
+	opts.commands = commands
+	return opts
+}
+
+// MaxEntries returns the configured LRU capacity.
+func (opts *CacheOptions) MaxEntries() int {
+	return opts.maxEntries
+}
+
+// TTL returns the configured cache entry lifetime, or zero if unset.
+func (opts *CacheOptions) TTL() time.Duration {
+	return opts.ttl
+}
+
+// AllowList returns the configured key allow-list.
+func (opts *CacheOptions) AllowList() []string {
+	return opts.allowList
+}
+
+// DenyList returns the configured key deny-list.
+func (opts *CacheOptions) DenyList() []string {
+	return opts.denyList
+}
+
+// Commands returns the configured per-command opt-in list.
+func (opts *CacheOptions) Commands() []string {
+	return opts.commands
+}
@@ -0,0 +1,261 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// ErrTxAborted is returned by a Pipeline created via Transaction when one of the watched keys
+// changed before EXEC, causing the server to abort the transaction.
+var ErrTxAborted = errors.New("api: transaction aborted, a watched key changed")
+
+// PipelineResult is a placeholder for the result of a single command queued on a Pipeline. It
+// is resolved in place once the enclosing Pipeline's Exec call returns; reading it beforehand
+// is a programming error.
+type PipelineResult[T any] struct {
+	value T
+	err   error
+	ready bool
+}
+
+// Get returns the value and error this command resolved to. It panics if called before the
+// enclosing Pipeline has been executed.
+func (r *PipelineResult[T]) Get() (T, error) {
+	if !r.ready {
+		panic("api: PipelineResult read before Pipeline.Exec completed")
+	}
+	return r.value, r.err
+}
+
+func (r *PipelineResult[T]) resolve(value T, err error) {
+	r.value = value
+	r.err = err
+	r.ready = true
+}
+
+// pipelineCommand is one command queued on a Pipeline: the request to send, the keys it
+// touches (used by GlideClusterClient to route it to the right shard), and the callback that
+// resolves its PipelineResult once a response comes back.
+type pipelineCommand struct {
+	requestType string
+	args        []string
+	keys        []string
+	resolve     func(response any, err error)
+}
+
+// batchExecutor is satisfied by GlideClient and GlideClusterClient. It sends a batch of
+// commands - wrapped in MULTI/EXEC when transactional, with an optional WATCH - to the server
+// in queue order, one command per round trip rather than in a single write, and returns one
+// batchResponse per command in order. A nil responses slice with a nil error signals that a
+// transaction was aborted because a watched key changed. The returned error reports a failure
+// of the batch as a whole (for example, a connection error); a single command failing in a
+// non-transactional batch is instead carried on that command's own batchResponse, since the
+// rest of the batch still ran.
+type batchExecutor interface {
+	executeBatch(
+		ctx context.Context,
+		commands []pipelineCommand,
+		transactional bool,
+		watch []string,
+	) (responses []batchResponse, err error)
+}
+
+// Pipeline queues commands client-side and sends them when Exec is called. Exec sends them in
+// queue order with one round trip per command; they are not combined into a single write.
+// Build one with GlideClient.Pipeline or
+// GlideClient.Transaction, queue commands on it, then call Exec to flush the batch and
+// resolve every PipelineResult, or Discard to drop it unexecuted.
+type Pipeline struct {
+	executor      batchExecutor
+	transactional bool
+	watch         []string
+	commands      []pipelineCommand
+	discarded     bool
+}
+
+func newPipeline(executor batchExecutor) *Pipeline {
+	return &Pipeline{executor: executor}
+}
+
+func newTransaction(executor batchExecutor, watch []string) *Pipeline {
+	return &Pipeline{executor: executor, transactional: true, watch: watch}
+}
+
+// Discard drops every command queued so far. The Pipeline must not be used after calling
+// Discard.
+func (p *Pipeline) Discard() {
+	p.discarded = true
+	p.commands = nil
+}
+
+// Exec sends every queued command to the server in queue order, one round trip per command,
+// reads the responses in order, and resolves each command's PipelineResult. For a Pipeline
+// returned by Transaction, the batch is wrapped in MULTI/EXEC and Exec returns ErrTxAborted if
+// a watched key changed before EXEC. For a non-transactional Pipeline, one command failing does
+// not stop the rest from resolving normally; Exec returns a *PipelineError describing which
+// ones failed. If the batch as a whole fails, every PipelineResult resolves to that error.
+func (p *Pipeline) Exec(ctx context.Context) error {
+	if p.discarded {
+		return errors.New("api: pipeline was discarded")
+	}
+
+	responses, err := p.executor.executeBatch(ctx, p.commands, p.transactional, p.watch)
+	if err == nil && responses == nil && p.transactional {
+		err = ErrTxAborted
+	}
+	if err != nil {
+		for _, cmd := range p.commands {
+			cmd.resolve(nil, err)
+		}
+		return err
+	}
+
+	var failures []PipelineCommandError
+	for i, cmd := range p.commands {
+		if i >= len(responses) {
+			continue
+		}
+		if responses[i].err != nil {
+			failures = append(failures, PipelineCommandError{Index: i, RequestType: cmd.requestType, Err: responses[i].err})
+			cmd.resolve(nil, responses[i].err)
+			continue
+		}
+		cmd.resolve(responses[i].value, nil)
+	}
+	if len(failures) > 0 {
+		return &PipelineError{Failures: failures}
+	}
+	return nil
+}
+
+func (p *Pipeline) queue(requestType string, keys []string, args []string, resolve func(response any, err error)) {
+	p.commands = append(p.commands, pipelineCommand{requestType: requestType, args: args, keys: keys, resolve: resolve})
+}
+
+func (p *Pipeline) Set(key string, value string) *PipelineResult[string] {
+	result := &PipelineResult[string]{}
+	p.queue("SET", []string{key}, []string{key, value}, func(response any, err error) {
+		if err != nil {
+			result.resolve("", err)
+			return
+		}
+		result.resolve(response.(string), nil)
+	})
+	return result
+}
+
+func (p *Pipeline) Get(key string) *PipelineResult[Result[string]] {
+	result := &PipelineResult[Result[string]]{}
+	p.queue("GET", []string{key}, []string{key}, func(response any, err error) {
+		if err != nil {
+			result.resolve(Result[string]{}, err)
+			return
+		}
+		if response == nil {
+			result.resolve(CreateNilStringResult(), nil)
+			return
+		}
+		result.resolve(CreateStringResult(response.(string)), nil)
+	})
+	return result
+}
+
+func (p *Pipeline) MGet(keys []string) *PipelineResult[[]Result[string]] {
+	result := &PipelineResult[[]Result[string]]{}
+	p.queue("MGET", keys, keys, func(response any, err error) {
+		if err != nil {
+			result.resolve(nil, err)
+			return
+		}
+		values := response.([]any)
+		results := make([]Result[string], len(values))
+		for i, value := range values {
+			if value == nil {
+				results[i] = CreateNilStringResult()
+			} else {
+				results[i] = CreateStringResult(value.(string))
+			}
+		}
+		result.resolve(results, nil)
+	})
+	return result
+}
+
+func (p *Pipeline) Incr(key string) *PipelineResult[int64] {
+	result := &PipelineResult[int64]{}
+	p.queue("INCR", []string{key}, []string{key}, func(response any, err error) {
+		if err != nil {
+			result.resolve(0, err)
+			return
+		}
+		result.resolve(response.(int64), nil)
+	})
+	return result
+}
+
+func (p *Pipeline) IncrBy(key string, amount int64) *PipelineResult[int64] {
+	result := &PipelineResult[int64]{}
+	p.queue("INCRBY", []string{key}, []string{key, strconv.FormatInt(amount, 10)}, func(response any, err error) {
+		if err != nil {
+			result.resolve(0, err)
+			return
+		}
+		result.resolve(response.(int64), nil)
+	})
+	return result
+}
+
+func (p *Pipeline) Append(key string, value string) *PipelineResult[int64] {
+	result := &PipelineResult[int64]{}
+	p.queue("APPEND", []string{key}, []string{key, value}, func(response any, err error) {
+		if err != nil {
+			result.resolve(0, err)
+			return
+		}
+		result.resolve(response.(int64), nil)
+	})
+	return result
+}
+
+func (p *Pipeline) GetRange(key string, start int64, end int64) *PipelineResult[string] {
+	result := &PipelineResult[string]{}
+	args := []string{key, strconv.FormatInt(start, 10), strconv.FormatInt(end, 10)}
+	p.queue("GETRANGE", []string{key}, args, func(response any, err error) {
+		if err != nil {
+			result.resolve("", err)
+			return
+		}
+		result.resolve(response.(string), nil)
+	})
+	return result
+}
+
+func (p *Pipeline) LCSWithOptions(key1 string, key2 string, opts options.LCSIdxOptions) *PipelineResult[map[string]any] {
+	result := &PipelineResult[map[string]any]{}
+	p.queue("LCS", []string{key1, key2}, []string{key1, key2}, func(response any, err error) {
+		if err != nil {
+			result.resolve(nil, err)
+			return
+		}
+		result.resolve(response.(map[string]any), nil)
+	})
+	return result
+}
+
+// Pipeline returns a Pipeline that queues commands for a single non-transactional batch write
+// against this client.
+func (client *GlideClient) Pipeline() *Pipeline {
+	return newPipeline(client)
+}
+
+// Transaction returns a Pipeline that wraps its queued commands in MULTI/EXEC. If any key in
+// watch changes before EXEC, Pipeline.Exec returns ErrTxAborted and none of the queued
+// commands are applied.
+func (client *GlideClient) Transaction(watch ...string) *Pipeline {
+	return newTransaction(client, watch)
+}
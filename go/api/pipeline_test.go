@@ -0,0 +1,78 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+func ExampleGlideClient_Pipeline() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	pipe := client.Pipeline()
+	setResult := pipe.Set("my_key", "my_value")
+	getResult := pipe.Get("my_key")
+	incrResult := pipe.Incr("my_counter")
+
+	if err := pipe.Exec(context.Background()); err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	set, _ := setResult.Get()
+	get, _ := getResult.Get()
+	incr, _ := incrResult.Get()
+	fmt.Println(set)
+	fmt.Println(get.Value())
+	fmt.Println(incr)
+
+	// Output:
+	// OK
+	// my_value
+	// 1
+}
+
+func ExampleGlideClient_Transaction() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	tx := client.Transaction("my_key")
+	setResult := tx.Set("my_key", "my_value")
+
+	if err := tx.Exec(context.Background()); err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	set, _ := setResult.Get()
+	fmt.Println(set)
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_Transaction_crossSlot() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	tx := client.Transaction("{my_key}1")
+	tx.Set("{my_key}1", "my_value")
+	tx.Set("{other_key}1", "my_value") // different hash tag, different slot
+
+	err := tx.Exec(context.Background())
+	fmt.Println(err == ErrCrossSlotTransaction)
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_Pipeline() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	pipe := client.Pipeline()
+	setResult := pipe.Set("{my_key}1", "my_value")
+
+	if err := pipe.Exec(context.Background()); err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	set, _ := setResult.Get()
+	fmt.Println(set)
+
+	// Output: OK
+}
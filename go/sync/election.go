@@ -0,0 +1,128 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// LeadershipEvent reports a leadership change observed by an Election.
+type LeadershipEvent struct {
+	// IsLeader is true when this Election just became the leader, false when it just lost
+	// leadership (lease expired, Resign was called, or ctx passed to Run was done).
+	IsLeader bool
+}
+
+// ElectionStats reports cumulative activity for an Election, backed by its underlying
+// Locker's LockerStats.
+type ElectionStats = LockerStats
+
+// Election layers leader-election over a Locker: callers read LeadershipEvent values from the
+// channel returned by Run to learn when they become, or stop being, the leader - useful for
+// implementing leader-only background workers.
+type Election struct {
+	locker *Locker
+
+	mu       sync.Mutex
+	isLeader bool
+}
+
+// NewElection returns an Election that campaigns for leadership using a lock keyed by prefix.
+// Every process racing for leadership must construct its Election with the same prefix.
+func NewElection(client Client, prefix string, opts LockOptions) *Election {
+	return &Election{locker: NewLocker(client, prefix+":leader", opts)}
+}
+
+// Run starts campaigning for leadership and returns a channel of LeadershipEvent values. The
+// channel is closed once ctx is done, after emitting a final IsLeader: false event if this
+// Election was leader at the time. Run must only be called once per Election.
+func (e *Election) Run(ctx context.Context) <-chan LeadershipEvent {
+	events := make(chan LeadershipEvent)
+	go e.campaign(ctx, events)
+	return events
+}
+
+func (e *Election) campaign(ctx context.Context, events chan<- LeadershipEvent) {
+	defer close(events)
+
+	for {
+		acquired, err := e.locker.TryLock(ctx)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+				continue
+			}
+		}
+		if !acquired {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+				continue
+			}
+		}
+
+		e.setLeader(true)
+		if !sendEvent(ctx, events, LeadershipEvent{IsLeader: true}) {
+			// ctx is already done, so a release sent with it would likely never reach the
+			// server - use a fresh context so Unlock actually has a chance to run.
+			e.locker.Unlock(context.Background())
+			e.setLeader(false)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			e.locker.Unlock(context.Background())
+			e.setLeader(false)
+			sendEvent(context.Background(), events, LeadershipEvent{IsLeader: false})
+			return
+		case <-e.locker.Lost():
+			e.setLeader(false)
+			if !sendEvent(ctx, events, LeadershipEvent{IsLeader: false}) {
+				return
+			}
+		}
+	}
+}
+
+// Resign immediately releases leadership, if held, instead of waiting for the lease to
+// expire.
+func (e *Election) Resign(ctx context.Context) error {
+	return e.locker.Unlock(ctx)
+}
+
+// IsLeader reports whether this Election currently believes it holds leadership. Because
+// leadership can be lost at any time (for example, if this process stalls past the lease
+// TTL), this is a best-effort snapshot, not a guarantee.
+func (e *Election) IsLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.isLeader
+}
+
+// Stats returns cumulative acquisition, contention, and lost-leadership counts for this
+// Election's underlying lock.
+func (e *Election) Stats() ElectionStats {
+	return e.locker.Stats()
+}
+
+func (e *Election) setLeader(isLeader bool) {
+	e.mu.Lock()
+	e.isLeader = isLeader
+	e.mu.Unlock()
+}
+
+// sendEvent delivers event unless ctx is done first, reporting whether it was delivered.
+func sendEvent(ctx context.Context, events chan<- LeadershipEvent, event LeadershipEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
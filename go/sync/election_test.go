@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestElectionRunBecomesLeaderAndResignsOnCancel(t *testing.T) {
+	client := newFakeClient()
+	election := NewElection(client, "prefix", LockOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := election.Run(ctx)
+
+	select {
+	case event := <-events:
+		if !event.IsLeader {
+			t.Fatalf("first event = %+v; want IsLeader true", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for leadership event")
+	}
+	if !election.IsLeader() {
+		t.Fatal("IsLeader() = false after becoming leader")
+	}
+
+	cancel()
+
+	select {
+	case event := <-events:
+		if event.IsLeader {
+			t.Fatalf("event after cancel = %+v; want IsLeader false", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for resignation event")
+	}
+	if election.IsLeader() {
+		t.Fatal("IsLeader() = true after ctx was cancelled")
+	}
+
+	if _, ok := <-events; ok {
+		t.Fatal("events channel was not closed after ctx was cancelled")
+	}
+
+	if election.Stats().Acquisitions < 1 {
+		t.Fatalf("Stats().Acquisitions = %d; want >= 1", election.Stats().Acquisitions)
+	}
+}
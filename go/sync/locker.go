@@ -0,0 +1,268 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package sync provides distributed locking and leader-election primitives built on top of
+// a GlideClient or GlideClusterClient, using SET NX PX for acquisition and a Lua script for
+// safe, fencing-token-aware release.
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Client is the subset of GlideClient and GlideClusterClient that Locker and Election need.
+// Both *api.GlideClient and *api.GlideClusterClient satisfy it. Locker is built entirely on
+// CustomCommand, rather than the typed SET API: acquisition is two round trips (INCR of the
+// fencing-token counter, then SET NX PX), while release and refresh are each a single EVAL.
+type Client interface {
+	CustomCommand(ctx context.Context, args []string) (any, error)
+}
+
+// ErrNotLocked is returned by Unlock and Refresh when the Locker does not currently hold the
+// lock, either because it was never acquired or because a prior Refresh discovered the lease
+// had been lost.
+var ErrNotLocked = errors.New("sync: locker does not hold the lock")
+
+// LockOptions configures a Locker.
+type LockOptions struct {
+	// LeaseTTL is how long the lock is held before it expires without a successful Refresh.
+	// Defaults to 30 seconds if zero.
+	LeaseTTL time.Duration
+}
+
+func (opts LockOptions) withDefaults() LockOptions {
+	if opts.LeaseTTL <= 0 {
+		opts.LeaseTTL = 30 * time.Second
+	}
+	return opts
+}
+
+// LockerStats reports cumulative activity for a Locker.
+type LockerStats struct {
+	Acquisitions    int64
+	ContestedWaits  int64
+	LostLeaderships int64
+}
+
+// releaseScript deletes the lock key only if it still holds the fencing token that acquired
+// it, preventing a Locker from releasing a lease another holder has since acquired.
+const releaseScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`
+
+// refreshScript extends the lock key's TTL only if it still holds the fencing token that
+// acquired it, for the same reason releaseScript checks it.
+const refreshScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`
+
+// Locker is a distributed mutual-exclusion lock keyed by a single Valkey key. Acquisition
+// uses SET key token NX PX leaseTTL; release and lease renewal run as Lua scripts that check
+// the stored fencing token before mutating anything, so a Locker can never release or extend
+// a lease another holder has since acquired.
+//
+// A Locker is not safe for concurrent use by multiple goroutines.
+type Locker struct {
+	client   Client
+	lockKey  string
+	fenceKey string
+	opts     LockOptions
+
+	mu     sync.Mutex
+	token  string
+	cancel context.CancelFunc
+	lost   chan struct{}
+
+	statsMu sync.Mutex
+	stats   LockerStats
+}
+
+// NewLocker returns a Locker for key. In cluster mode, key's lock and fencing-token state are
+// stored under the same hash tag so the release and refresh scripts always execute on a
+// single shard.
+func NewLocker(client Client, key string, opts LockOptions) *Locker {
+	return &Locker{
+		client:   client,
+		lockKey:  fmt.Sprintf("{%s}:lock", key),
+		fenceKey: fmt.Sprintf("{%s}:fence", key),
+		opts:     opts.withDefaults(),
+	}
+}
+
+// Lock blocks, retrying every 100ms, until the lock is acquired or ctx is done.
+func (l *Locker) Lock(ctx context.Context) error {
+	for {
+		acquired, err := l.TryLock(ctx)
+		if err != nil {
+			return err
+		}
+		if acquired {
+			return nil
+		}
+
+		l.statsMu.Lock()
+		l.stats.ContestedWaits++
+		l.statsMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// TryLock attempts to acquire the lock once, returning false (with a nil error) if another
+// holder currently has it. On success, a background goroutine refreshes the lease at
+// LeaseTTL/3 until Unlock is called or ctx is done.
+func (l *Locker) TryLock(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	fencingToken, err := l.nextFencingToken(ctx)
+	if err != nil {
+		return false, err
+	}
+	token := strconv.FormatInt(fencingToken, 10)
+	ttlMs := strconv.FormatInt(l.opts.LeaseTTL.Milliseconds(), 10)
+
+	response, err := l.client.CustomCommand(ctx, []string{"SET", l.lockKey, token, "NX", "PX", ttlMs})
+	if err != nil {
+		return false, err
+	}
+	if response == nil {
+		// NX prevented the SET; another holder already has the lock.
+		return false, nil
+	}
+
+	l.token = token
+	l.lost = make(chan struct{})
+	lockCtx, cancel := context.WithCancel(ctx)
+	l.cancel = cancel
+	go l.refreshLoop(lockCtx, token, l.lost)
+
+	l.statsMu.Lock()
+	l.stats.Acquisitions++
+	l.statsMu.Unlock()
+	return true, nil
+}
+
+// Unlock releases the lock if it is still held, stopping the background refresh goroutine.
+// It is a no-op, returning ErrNotLocked, if the Locker does not currently hold the lock.
+func (l *Locker) Unlock(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.token == "" {
+		return ErrNotLocked
+	}
+	l.stopRefresh()
+
+	_, err := l.client.CustomCommand(ctx, []string{"EVAL", releaseScript, "1", l.lockKey, l.token})
+	l.token = ""
+	l.lost = nil
+	return err
+}
+
+// Refresh extends the lock's lease by LeaseTTL immediately, outside of the background refresh
+// goroutine's normal schedule. It returns ErrNotLocked if the Locker does not currently hold
+// the lock, including if a prior refresh discovered the lease had been lost.
+func (l *Locker) Refresh(ctx context.Context) error {
+	l.mu.Lock()
+	token := l.token
+	l.mu.Unlock()
+
+	if token == "" {
+		return ErrNotLocked
+	}
+	return l.refreshOnce(ctx, token)
+}
+
+// Lost returns a channel that closes when the background refresh goroutine discovers this
+// Locker no longer holds the lease, or nil if the lock is not currently held.
+func (l *Locker) Lost() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lost
+}
+
+// Stats returns cumulative acquisition, contention, and lost-lease counts for this Locker.
+func (l *Locker) Stats() LockerStats {
+	l.statsMu.Lock()
+	defer l.statsMu.Unlock()
+	return l.stats
+}
+
+// stopRefresh cancels the background refresh goroutine, if any. It does not wait for the
+// goroutine to exit. Callers must hold l.mu.
+func (l *Locker) stopRefresh() {
+	if l.cancel != nil {
+		l.cancel()
+		l.cancel = nil
+	}
+}
+
+func (l *Locker) refreshLoop(ctx context.Context, token string, lost chan struct{}) {
+	ticker := time.NewTicker(l.opts.LeaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.refreshOnce(ctx, token); err != nil {
+				l.mu.Lock()
+				if l.token == token {
+					l.token = ""
+				}
+				l.mu.Unlock()
+
+				l.statsMu.Lock()
+				l.stats.LostLeaderships++
+				l.statsMu.Unlock()
+				close(lost)
+				return
+			}
+		}
+	}
+}
+
+// refreshOnce runs refreshScript once and translates a "not the owner" result into
+// ErrNotLocked.
+func (l *Locker) refreshOnce(ctx context.Context, token string) error {
+	ttlMs := strconv.FormatInt(l.opts.LeaseTTL.Milliseconds(), 10)
+	response, err := l.client.CustomCommand(ctx, []string{"EVAL", refreshScript, "1", l.lockKey, token, ttlMs})
+	if err != nil {
+		return err
+	}
+	if extended, ok := response.(int64); !ok || extended == 0 {
+		return ErrNotLocked
+	}
+	return nil
+}
+
+// nextFencingToken atomically increments the lock's fencing-token counter and returns the new
+// value. Fencing tokens are monotonically increasing across acquisitions of the same key,
+// even across TryLock calls that fail to acquire the lock.
+func (l *Locker) nextFencingToken(ctx context.Context) (int64, error) {
+	response, err := l.client.CustomCommand(ctx, []string{"INCR", l.fenceKey})
+	if err != nil {
+		return 0, err
+	}
+	token, ok := response.(int64)
+	if !ok {
+		return 0, fmt.Errorf("sync: unexpected INCR response type %T", response)
+	}
+	return token, nil
+}
@@ -0,0 +1,133 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package sync
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// fakeClient is a minimal Client fixture backed by an in-memory map. It understands exactly
+// the commands Locker issues: SET ... NX PX, EVAL of releaseScript/refreshScript, and INCR.
+type fakeClient struct {
+	mu       sync.Mutex
+	values   map[string]string
+	counters map[string]int64
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{values: make(map[string]string), counters: make(map[string]int64)}
+}
+
+func (f *fakeClient) CustomCommand(ctx context.Context, args []string) (any, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch args[0] {
+	case "SET":
+		key, value := args[1], args[2]
+		if _, exists := f.values[key]; exists {
+			return nil, nil
+		}
+		f.values[key] = value
+		return "OK", nil
+	case "EVAL":
+		script, key, token := args[1], args[3], args[4]
+		if f.values[key] != token {
+			return int64(0), nil
+		}
+		switch script {
+		case releaseScript:
+			delete(f.values, key)
+		case refreshScript:
+			// Renewal doesn't need to do anything observable; the fixture doesn't model TTLs.
+		}
+		return int64(1), nil
+	case "INCR":
+		key := args[1]
+		f.counters[key]++
+		return f.counters[key], nil
+	default:
+		panic("fakeClient: unexpected command " + args[0])
+	}
+}
+
+func TestLockerTryLockAcquireAndRelease(t *testing.T) {
+	client := newFakeClient()
+	locker := NewLocker(client, "resource", LockOptions{})
+
+	acquired, err := locker.TryLock(context.Background())
+	if err != nil || !acquired {
+		t.Fatalf("TryLock() = %v, %v; want true, nil", acquired, err)
+	}
+
+	other := NewLocker(client, "resource", LockOptions{})
+	acquired, err = other.TryLock(context.Background())
+	if err != nil || acquired {
+		t.Fatalf("TryLock() on held lock = %v, %v; want false, nil", acquired, err)
+	}
+
+	if err := locker.Unlock(context.Background()); err != nil {
+		t.Fatalf("Unlock() = %v; want nil", err)
+	}
+
+	acquired, err = other.TryLock(context.Background())
+	if err != nil || !acquired {
+		t.Fatalf("TryLock() after Unlock = %v, %v; want true, nil", acquired, err)
+	}
+}
+
+func TestLockerUnlockNotLocked(t *testing.T) {
+	locker := NewLocker(newFakeClient(), "resource", LockOptions{})
+	if err := locker.Unlock(context.Background()); err != ErrNotLocked {
+		t.Fatalf("Unlock() = %v; want ErrNotLocked", err)
+	}
+}
+
+func TestLockerRefresh(t *testing.T) {
+	client := newFakeClient()
+	locker := NewLocker(client, "resource", LockOptions{})
+
+	if err := locker.Refresh(context.Background()); err != ErrNotLocked {
+		t.Fatalf("Refresh() before lock held = %v; want ErrNotLocked", err)
+	}
+
+	if _, err := locker.TryLock(context.Background()); err != nil {
+		t.Fatalf("TryLock() = %v; want nil", err)
+	}
+	if err := locker.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() = %v; want nil", err)
+	}
+
+	if err := locker.Unlock(context.Background()); err != nil {
+		t.Fatalf("Unlock() = %v; want nil", err)
+	}
+	if err := locker.Refresh(context.Background()); err != ErrNotLocked {
+		t.Fatalf("Refresh() after Unlock = %v; want ErrNotLocked", err)
+	}
+}
+
+func TestLockerFencingTokenMonotonic(t *testing.T) {
+	client := newFakeClient()
+	locker := NewLocker(client, "resource", LockOptions{})
+
+	if _, err := locker.TryLock(context.Background()); err != nil {
+		t.Fatalf("TryLock() = %v; want nil", err)
+	}
+	first := locker.token
+	if err := locker.Unlock(context.Background()); err != nil {
+		t.Fatalf("Unlock() = %v; want nil", err)
+	}
+
+	if _, err := locker.TryLock(context.Background()); err != nil {
+		t.Fatalf("TryLock() = %v; want nil", err)
+	}
+	second := locker.token
+	firstN, _ := strconv.ParseInt(first, 10, 64)
+	secondN, _ := strconv.ParseInt(second, 10, 64)
+	if secondN <= firstN {
+		t.Fatalf("fencing token did not increase across acquisitions: %q then %q", first, second)
+	}
+}